@@ -0,0 +1,299 @@
+/*
+Copyright 2025 Valkey Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValkeyClusterSpec defines the desired state of ValkeyCluster.
+type ValkeyClusterSpec struct {
+	// Shards is the number of master shards in the cluster.
+	// +kubebuilder:validation:Minimum=1
+	Shards int32 `json:"shards"`
+
+	// Replicas is the number of replicas per shard.
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// TLS configures transport encryption for client-facing connections.
+	// +optional
+	TLS *TLSSpec `json:"tls,omitempty"`
+
+	// Exporter configures the Prometheus metrics sidecar deployed alongside each Valkey pod.
+	// +optional
+	Exporter ExporterSpec `json:"exporter,omitempty"`
+
+	// Auth configures password authentication for the Valkey server. When set, the controller
+	// renders an ACL configuration whose admin user is the implicit "default" user (Username unset)
+	// or Username (Username set), and creates a dedicated read-only ACL user for the metrics exporter.
+	// +optional
+	Auth *AuthSpec `json:"auth,omitempty"`
+
+	// ClusterBus configures transport encryption for the gossip/replication port between shard
+	// pods, independent of Spec.TLS which only covers client-facing connections.
+	// +optional
+	ClusterBus *ClusterBusSpec `json:"clusterBus,omitempty"`
+}
+
+// ClusterBusSpec configures encryption for the cluster bus (gossip/replication) port.
+type ClusterBusSpec struct {
+	// Encryption selects the transport encryption mode for the cluster bus port.
+	// +kubebuilder:validation:Enum=none;tls;wireguard
+	// +kubebuilder:default=none
+	// +optional
+	Encryption string `json:"encryption,omitempty"`
+}
+
+// Cluster bus encryption modes accepted by ClusterBusSpec.Encryption.
+const (
+	ClusterBusEncryptionNone      = "none"
+	ClusterBusEncryptionTLS       = "tls"
+	ClusterBusEncryptionWireGuard = "wireguard"
+)
+
+// AuthSpec configures password authentication for the Valkey server.
+type AuthSpec struct {
+	// Username is the Valkey ACL user the operator authenticates as. Leave empty to use
+	// requirepass-style single-password auth against the implicit "default" user.
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// PasswordSecretRef points at the Secret key holding the password for Username (or, when
+	// Username is empty, the requirepass value).
+	PasswordSecretRef corev1.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// TLSSpec configures TLS for client-facing connections.
+type TLSSpec struct {
+	// Enabled turns on TLS for client connections.
+	Enabled bool `json:"enabled"`
+
+	// ExistingSecret is the name of a Secret containing the certificate, key, and CA.
+	// +optional
+	ExistingSecret string `json:"existingSecret,omitempty"`
+
+	// Cert is the key within ExistingSecret holding the certificate.
+	// +optional
+	Cert string `json:"cert,omitempty"`
+
+	// Key is the key within ExistingSecret holding the private key.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// CA is the key within ExistingSecret holding the CA certificate.
+	// +optional
+	CA string `json:"ca,omitempty"`
+}
+
+// ExporterSpec configures the redis_exporter sidecar that exposes Valkey metrics.
+type ExporterSpec struct {
+	// Image overrides the default exporter image.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Resources describes the compute resource requirements for the exporter container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// ServiceMonitor configures Prometheus Operator discovery of the exporter's /metrics endpoint.
+	// +optional
+	ServiceMonitor *ServiceMonitorSpec `json:"serviceMonitor,omitempty"`
+
+	// TLS configures TLS for the exporter sidecar, both for serving /metrics over HTTPS and for
+	// its connection to the Valkey server.
+	// +optional
+	TLS *ExporterTLSSpec `json:"tls,omitempty"`
+
+	// ExtraArgs are appended verbatim to the redis_exporter command line, after the flags the
+	// controller derives from CheckKeys, CheckStreams, and ScriptConfigMapRef.
+	// +optional
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+
+	// ExtraEnv are additional environment variables merged into the exporter container. Names that
+	// collide with a variable the controller sets itself (see ReservedExporterEnvNames) are rejected
+	// by the validating webhook.
+	// +optional
+	ExtraEnv []corev1.EnvVar `json:"extraEnv,omitempty"`
+
+	// CheckKeys is a list of key patterns passed to redis_exporter's --check-keys flag.
+	// +optional
+	CheckKeys []string `json:"checkKeys,omitempty"`
+
+	// CheckSingleKeys is a list of individual (non-glob) keys passed to redis_exporter's
+	// --check-single-keys flag.
+	// +optional
+	CheckSingleKeys []string `json:"checkSingleKeys,omitempty"`
+
+	// CheckStreams is a list of stream key patterns passed to redis_exporter's --check-streams flag.
+	// +optional
+	CheckStreams []string `json:"checkStreams,omitempty"`
+
+	// ScriptConfigMapRef mounts a ConfigMap of Lua scripts into the exporter sidecar; every key
+	// becomes a file under /scripts, appended to redis_exporter's --script flag.
+	// +optional
+	ScriptConfigMapRef *corev1.LocalObjectReference `json:"scriptConfigMapRef,omitempty"`
+}
+
+// ReservedExporterEnvNames are the environment variable names the controller sets on the exporter
+// container itself. ExporterSpec.ExtraEnv entries using one of these names are rejected by the
+// validating webhook and stripped defensively when the container is generated.
+var ReservedExporterEnvNames = map[string]struct{}{
+	"REDIS_ADDR":                             {},
+	"REDIS_EXPORTER_WEB_LISTEN_ADDRESS":      {},
+	"REDIS_EXPORTER_TLS_CA_CERT_FILE":        {},
+	"REDIS_EXPORTER_TLS_CLIENT_CERT_FILE":    {},
+	"REDIS_EXPORTER_TLS_CLIENT_KEY_FILE":     {},
+	"REDIS_EXPORTER_SKIP_TLS_VERIFICATION":   {},
+	"REDIS_EXPORTER_TLS_SERVER_CERT_FILE":    {},
+	"REDIS_EXPORTER_TLS_SERVER_KEY_FILE":     {},
+	"REDIS_EXPORTER_TLS_SERVER_CA_CERT_FILE": {},
+	"REDIS_USER":                             {},
+	"REDIS_PASSWORD":                         {},
+}
+
+// ExporterTLSSpec configures TLS on the metrics exporter sidecar.
+type ExporterTLSSpec struct {
+	// Enabled serves /metrics over HTTPS using ServerCertSecret, and flips the liveness/readiness
+	// probes to HTTPSGetAction.
+	Enabled bool `json:"enabled"`
+
+	// ServerCertSecret is the name of a Secret (tls.crt, tls.key, ca.crt) used to serve /metrics
+	// over HTTPS. Required when Enabled is true.
+	// +optional
+	ServerCertSecret string `json:"serverCertSecret,omitempty"`
+
+	// ClientCertSecret names a Secret for the exporter's TLS client connection to the Valkey
+	// server. The generated exporter container does not read this field: it always presents the
+	// certificate from the cluster's Spec.TLS.ExistingSecret.
+	// +optional
+	ClientCertSecret string `json:"clientCertSecret,omitempty"`
+
+	// InsecureSkipVerify disables server certificate verification on the exporter's connection to
+	// Valkey. Must be explicitly set to true; the controller never enables this implicitly.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// ServiceMonitorSpec configures the Prometheus Operator ServiceMonitor (or PodMonitor) generated
+// for the metrics exporter sidecar.
+type ServiceMonitorSpec struct {
+	// Enabled creates a ServiceMonitor (or PodMonitor, see UsePodMonitor) targeting the metrics port.
+	Enabled bool `json:"enabled"`
+
+	// UsePodMonitor generates a PodMonitor instead of a ServiceMonitor. Useful when the exporter
+	// pods aren't fronted by a stable Service.
+	// +optional
+	UsePodMonitor bool `json:"usePodMonitor,omitempty"`
+
+	// Interval is the scrape interval, e.g. "30s". Defaults to the Prometheus Operator default.
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// ScrapeTimeout is the per-scrape timeout, e.g. "10s".
+	// +optional
+	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+
+	// HonorLabels instructs Prometheus to keep scraped labels over server-side ones on conflict.
+	// +optional
+	HonorLabels bool `json:"honorLabels,omitempty"`
+
+	// AdditionalLabels are applied to the generated ServiceMonitor/PodMonitor object itself, so it
+	// can be matched by a Prometheus resource's serviceMonitorSelector/podMonitorSelector.
+	// +optional
+	AdditionalLabels map[string]string `json:"additionalLabels,omitempty"`
+}
+
+// ValkeyClusterStatus defines the observed state of ValkeyCluster.
+type ValkeyClusterStatus struct {
+	// Conditions represent the latest available observations of the cluster's state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Auth tracks the state of credential propagation to the running cluster.
+	// +optional
+	Auth *AuthStatus `json:"auth,omitempty"`
+
+	// Shards reports the per-shard health observed by the cluster-health prober.
+	// +optional
+	Shards []ShardStatus `json:"shards,omitempty"`
+
+	// ClusterBus tracks the state of cluster bus encryption key propagation.
+	// +optional
+	ClusterBus *ClusterBusStatus `json:"clusterBus,omitempty"`
+}
+
+// ClusterBusStatus tracks which version of each pod's WireGuard keypair Secret has been applied to
+// the running cluster bus, so the key rotation reconciler can detect drift without restarting
+// pods. Each pod has its own keypair Secret (see wireGuardKeySecretName), so drift is tracked
+// per pod rather than as a single cluster-wide resourceVersion.
+type ClusterBusStatus struct {
+	// ObservedWireGuardSecretResourceVersions maps pod name to the resourceVersion of that pod's
+	// WireGuard keypair Secret that was last applied to its wg0 interface.
+	// +optional
+	ObservedWireGuardSecretResourceVersions map[string]string `json:"observedWireGuardSecretResourceVersions,omitempty"`
+}
+
+// ShardStatus reports the health of a single shard as last observed by the ClusterHealthProber.
+type ShardStatus struct {
+	// Name is the name of the probed pod. The prober reports one entry per pod, replicas included,
+	// so a shard with replicas appears once per pod.
+	Name string `json:"name"`
+
+	// Healthy is true when the shard's node reports cluster_state:ok, all of its slots assigned,
+	// and no down links to its peers.
+	Healthy bool `json:"healthy"`
+
+	// AssignedSlots is the cluster_slots_assigned value the pod's node reports, i.e. the number of
+	// hash slots assigned across the whole cluster, not only to this shard.
+	AssignedSlots int32 `json:"assignedSlots"`
+}
+
+// AuthStatus tracks which version of the exporter's ACL credentials has been applied to the
+// running cluster, so the password rotation reconciler can detect drift without restarting pods.
+type AuthStatus struct {
+	// ObservedExporterSecretResourceVersion is the resourceVersion of the exporter ACL user's
+	// password Secret that was last applied via ACL SETUSER.
+	// +optional
+	ObservedExporterSecretResourceVersion string `json:"observedExporterSecretResourceVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ValkeyCluster is the Schema for the valkeyclusters API.
+type ValkeyCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ValkeyClusterSpec   `json:"spec,omitempty"`
+	Status ValkeyClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ValkeyClusterList contains a list of ValkeyCluster.
+type ValkeyClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ValkeyCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ValkeyCluster{}, &ValkeyClusterList{})
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025 Valkey Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager registers the ValkeyCluster validating webhook with mgr.
+func (r *ValkeyCluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&ValkeyClusterCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-valkey-io-v1alpha1-valkeycluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=valkey.io,resources=valkeyclusters,verbs=create;update,versions=v1alpha1,name=vvalkeycluster.valkey.io,admissionReviewVersions=v1
+
+var _ webhook.CustomValidator = &ValkeyClusterCustomValidator{}
+
+// ValkeyClusterCustomValidator validates ValkeyCluster admission requests.
+type ValkeyClusterCustomValidator struct{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *ValkeyClusterCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cluster, ok := obj.(*ValkeyCluster)
+	if !ok {
+		return nil, fmt.Errorf("expected a ValkeyCluster but got %T", obj)
+	}
+	return nil, validateExporterExtraEnv(cluster).ToAggregate()
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *ValkeyClusterCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	cluster, ok := newObj.(*ValkeyCluster)
+	if !ok {
+		return nil, fmt.Errorf("expected a ValkeyCluster but got %T", newObj)
+	}
+	return nil, validateExporterExtraEnv(cluster).ToAggregate()
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *ValkeyClusterCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateExporterExtraEnv rejects Spec.Exporter.ExtraEnv entries whose name collides with a
+// variable the controller already sets on the exporter container (REDIS_ADDR, the TLS file-path
+// variables, REDIS_USER/REDIS_PASSWORD, ...), since the controller's value would otherwise be
+// silently overridden or duplicated.
+func validateExporterExtraEnv(cluster *ValkeyCluster) field.ErrorList {
+	var errs field.ErrorList
+	fldPath := field.NewPath("spec", "exporter", "extraEnv")
+	for i, env := range cluster.Spec.Exporter.ExtraEnv {
+		if _, reserved := ReservedExporterEnvNames[env.Name]; reserved {
+			errs = append(errs, field.Invalid(fldPath.Index(i).Child("name"), env.Name, "is managed by the controller and cannot be overridden"))
+		}
+	}
+	return errs
+}
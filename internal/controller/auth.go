@@ -0,0 +1,187 @@
+/*
+Copyright 2025 Valkey Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	valkeyiov1alpha1 "valkey.io/valkey-operator/api/v1alpha1"
+)
+
+const (
+	// exporterACLUsername is the dedicated, read-only Valkey ACL user the metrics exporter
+	// authenticates as, so a compromised scrape endpoint can't be used to mutate data.
+	exporterACLUsername = "exporter"
+
+	exporterAuthSecretPasswordKey = "password"
+)
+
+// exporterAuthSecretName is the Secret the controller manages containing the generated password
+// for exporterACLUsername.
+func exporterAuthSecretName(cluster *valkeyiov1alpha1.ValkeyCluster) string {
+	return cluster.Name + "-exporter-auth"
+}
+
+// getExporterAuthEnvironmentVariables returns the REDIS_USER/REDIS_PASSWORD environment variables
+// for the exporter sidecar. The password is always sourced from a SecretKeyRef, never inlined as a
+// literal value. Returns nil when the cluster has no auth configured.
+func getExporterAuthEnvironmentVariables(cluster *valkeyiov1alpha1.ValkeyCluster) []corev1.EnvVar {
+	if cluster.Spec.Auth == nil {
+		return nil
+	}
+	return []corev1.EnvVar{
+		{Name: "REDIS_USER", Value: exporterACLUsername},
+		{
+			Name: "REDIS_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: exporterAuthSecretName(cluster)},
+					Key:                  exporterAuthSecretPasswordKey,
+				},
+			},
+		},
+	}
+}
+
+// reconcileExporterAuthSecret ensures the Secret backing exporterACLUsername's password exists,
+// generating a random password on first creation. It never overwrites an existing password; when
+// this Secret changes, reconcileExporterACLRotation applies its password to the running nodes.
+func (r *ValkeyClusterReconciler) reconcileExporterAuthSecret(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster) (*corev1.Secret, error) {
+	if cluster.Spec.Auth == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: exporterAuthSecretName(cluster), Namespace: cluster.Namespace}}
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+	switch {
+	case err == nil:
+		return secret, nil
+	case apierrors.IsNotFound(err):
+	default:
+		return nil, fmt.Errorf("failed to get exporter auth secret: %w", err)
+	}
+
+	password, err := generatePassword()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate exporter password: %w", err)
+	}
+	secret.Data = map[string][]byte{exporterAuthSecretPasswordKey: []byte(password)}
+	if err := controllerutil.SetControllerReference(cluster, secret, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference on exporter auth secret: %w", err)
+	}
+	if err := r.Client.Create(ctx, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("failed to create exporter auth secret: %w", err)
+	}
+	return secret, nil
+}
+
+// renderACLFile renders the Valkey ACL file content for cluster, combining the user-supplied
+// admin credentials from Spec.Auth with the dedicated read-only exporter user. When Spec.Auth.Username
+// is empty, adminPassword is applied as the "default" user's requirepass via a `user default` ACL
+// line rather than the legacy `requirepass` directive, so both users can coexist in a single file.
+//
+// adminPassword comes from the user-controlled Spec.Auth.PasswordSecretRef, unlike exporterPassword
+// which is always generatePassword()'s own output, so it is validated before being interpolated
+// into the ACL line: a password containing a newline or space could otherwise inject an extra ACL
+// directive (e.g. granting another user +@all).
+func renderACLFile(cluster *valkeyiov1alpha1.ValkeyCluster, adminPassword, exporterPassword string) (string, error) {
+	if err := validateACLPassword(adminPassword); err != nil {
+		return "", fmt.Errorf("invalid admin password: %w", err)
+	}
+
+	adminUser := "default"
+	if cluster.Spec.Auth != nil && cluster.Spec.Auth.Username != "" {
+		adminUser = cluster.Spec.Auth.Username
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "user %s on >%s ~* &* +@all\n", adminUser, adminPassword)
+	fmt.Fprintf(&b, "user %s on >%s ~* +@read -@write -@dangerous\n", exporterACLUsername, exporterPassword)
+	return b.String(), nil
+}
+
+// validateACLPassword rejects characters that would let a password break out of its `>password`
+// token in a rendered ACL line and inject additional directives.
+func validateACLPassword(password string) error {
+	if strings.ContainsAny(password, "\n\r \t>") {
+		return fmt.Errorf("password must not contain whitespace or '>' characters")
+	}
+	return nil
+}
+
+// generatePassword returns a URL-safe, base64-encoded random password suitable for a Valkey ACL user.
+func generatePassword() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// valkeyACLClient issues administrative ACL commands against a running Valkey node. Implemented by
+// a thin wrapper around the Valkey client so this package can be tested against a fake.
+type valkeyACLClient interface {
+	ACLSetUser(ctx context.Context, username string, rules ...string) error
+}
+
+// reconcileExporterACLRotation re-issues ACL SETUSER for exporterACLUsername against every node in
+// the cluster whenever the exporter auth Secret's resourceVersion changes, so a password rotation
+// takes effect without restarting any pods. It updates Status.Auth once applied to every node.
+func (r *ValkeyClusterReconciler) reconcileExporterACLRotation(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster, nodes []valkeyACLClient) error {
+	if cluster.Spec.Auth == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: exporterAuthSecretName(cluster), Namespace: cluster.Namespace}, secret); err != nil {
+		return fmt.Errorf("failed to get exporter auth secret: %w", err)
+	}
+
+	observed := ""
+	if cluster.Status.Auth != nil {
+		observed = cluster.Status.Auth.ObservedExporterSecretResourceVersion
+	}
+	if observed == secret.ResourceVersion {
+		return nil
+	}
+
+	password := string(secret.Data[exporterAuthSecretPasswordKey])
+	for _, node := range nodes {
+		if err := node.ACLSetUser(ctx, exporterACLUsername, "on", ">"+password, "~*", "+@read", "-@write", "-@dangerous"); err != nil {
+			return fmt.Errorf("failed to rotate exporter ACL user: %w", err)
+		}
+	}
+
+	if cluster.Status.Auth == nil {
+		cluster.Status.Auth = &valkeyiov1alpha1.AuthStatus{}
+	}
+	cluster.Status.Auth.ObservedExporterSecretResourceVersion = secret.ResourceVersion
+	log.FromContext(ctx).Info("rotated exporter ACL password", "resourceVersion", secret.ResourceVersion)
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 Valkey Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	valkeyiov1alpha1 "valkey.io/valkey-operator/api/v1alpha1"
+)
+
+func TestRenderACLFileDefaultUser(t *testing.T) {
+	cluster := &valkeyiov1alpha1.ValkeyCluster{
+		Spec: valkeyiov1alpha1.ValkeyClusterSpec{
+			Auth: &valkeyiov1alpha1.AuthSpec{},
+		},
+	}
+
+	acl, err := renderACLFile(cluster, "adminpw", "exporterpw")
+	if err != nil {
+		t.Fatalf("renderACLFile() returned error: %v", err)
+	}
+
+	if !strings.Contains(acl, "user default on >adminpw ~* &* +@all\n") {
+		t.Errorf("expected default user ACL line, got:\n%s", acl)
+	}
+	if !strings.Contains(acl, "user exporter on >exporterpw ~* +@read -@write -@dangerous\n") {
+		t.Errorf("expected exporter user ACL line, got:\n%s", acl)
+	}
+}
+
+func TestRenderACLFileNamedUser(t *testing.T) {
+	cluster := &valkeyiov1alpha1.ValkeyCluster{
+		Spec: valkeyiov1alpha1.ValkeyClusterSpec{
+			Auth: &valkeyiov1alpha1.AuthSpec{Username: "admin"},
+		},
+	}
+
+	acl, err := renderACLFile(cluster, "adminpw", "exporterpw")
+	if err != nil {
+		t.Fatalf("renderACLFile() returned error: %v", err)
+	}
+
+	if !strings.Contains(acl, "user admin on >adminpw ~* &* +@all\n") {
+		t.Errorf("expected named admin user ACL line, got:\n%s", acl)
+	}
+	if strings.Contains(acl, "user default") {
+		t.Errorf("did not expect a default user ACL line when Username is set, got:\n%s", acl)
+	}
+}
+
+func TestRenderACLFileRejectsInjectionViaAdminPassword(t *testing.T) {
+	cluster := &valkeyiov1alpha1.ValkeyCluster{
+		Spec: valkeyiov1alpha1.ValkeyClusterSpec{
+			Auth: &valkeyiov1alpha1.AuthSpec{},
+		},
+	}
+
+	for _, malicious := range []string{
+		"pw\nuser attacker on nopass ~* &* +@all",
+		"pw\ruser attacker on nopass ~* &* +@all",
+		"pw with spaces",
+		"pw>extra",
+	} {
+		if _, err := renderACLFile(cluster, malicious, "exporterpw"); err == nil {
+			t.Errorf("expected renderACLFile to reject admin password %q, got nil error", malicious)
+		}
+	}
+}
+
+func TestGeneratePasswordIsRandomAndURLSafe(t *testing.T) {
+	a, err := generatePassword()
+	if err != nil {
+		t.Fatalf("generatePassword() returned error: %v", err)
+	}
+	b, err := generatePassword()
+	if err != nil {
+		t.Fatalf("generatePassword() returned error: %v", err)
+	}
+
+	if a == b {
+		t.Errorf("expected two successive calls to generatePassword() to differ, both returned %q", a)
+	}
+	if strings.ContainsAny(a, "+/=") {
+		t.Errorf("expected a URL-safe, unpadded password, got %q", a)
+	}
+}
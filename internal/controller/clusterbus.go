@@ -0,0 +1,245 @@
+/*
+Copyright 2025 Valkey Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"golang.org/x/crypto/curve25519"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	valkeyiov1alpha1 "valkey.io/valkey-operator/api/v1alpha1"
+)
+
+const (
+	wireGuardPrivateKeyDataKey = "privateKey"
+	wireGuardPublicKeyDataKey  = "publicKey"
+
+	// wireGuardInterfaceName is the interface the init container brings up inside every pod.
+	wireGuardInterfaceName = "wg0"
+
+	// wireGuardSubnet is the /24 the cluster bus's WireGuard mesh is addressed from; each pod gets
+	// wireGuardSubnet.<ordinal+1>.
+	wireGuardSubnet = "10.100.0"
+
+	// wireGuardListenPort is the UDP port the WireGuard interface listens on.
+	wireGuardListenPort = 51820
+
+	// DefaultWireGuardToolsImage provides the wg(8) and ip(8) binaries the init container needs.
+	DefaultWireGuardToolsImage = "ghcr.io/wireguard-tools/wireguard-tools:latest"
+
+	// wireGuardKeyVolumeName and wireGuardKeyMountPath are where the pod's WireGuard keypair
+	// Secret (see reconcileWireGuardKeypairSecret) is mounted into the init container, so the
+	// private key never appears in the Pod spec, etcd, or audit logs.
+	wireGuardKeyVolumeName = "wireguard-keys"
+	wireGuardKeyMountPath  = "/etc/wireguard"
+)
+
+// clusterBusEncryptionMode returns cluster.Spec.ClusterBus.Encryption, defaulting to "none".
+func clusterBusEncryptionMode(cluster *valkeyiov1alpha1.ValkeyCluster) string {
+	if cluster.Spec.ClusterBus == nil || cluster.Spec.ClusterBus.Encryption == "" {
+		return valkeyiov1alpha1.ClusterBusEncryptionNone
+	}
+	return cluster.Spec.ClusterBus.Encryption
+}
+
+// clusterBusTLSDirectives returns the valkey.conf directives that enable TLS on the cluster bus,
+// reusing the same certificate material generateMetricsExporterContainerDef resolves via
+// getTLSFileNames. Intended for the (separate) config renderer that builds valkey.conf.
+func clusterBusTLSDirectives() []string {
+	return []string{"tls-cluster yes", "tls-replication yes"}
+}
+
+// wireGuardKeySecretName is the Secret holding pod's WireGuard keypair for cluster.
+func wireGuardKeySecretName(cluster *valkeyiov1alpha1.ValkeyCluster, podName string) string {
+	return fmt.Sprintf("%s-wg-%s", cluster.Name, podName)
+}
+
+// wireGuardPodAddress returns the WireGuard mesh address assigned to the pod at ordinal.
+func wireGuardPodAddress(ordinal int) string {
+	return fmt.Sprintf("%s.%d", wireGuardSubnet, ordinal+1)
+}
+
+// generateWireGuardKeypair returns a base64-encoded Curve25519 keypair in WireGuard's key format.
+func generateWireGuardKeypair() (privateKey, publicKey string, err error) {
+	var priv [32]byte
+	if _, err := rand.Read(priv[:]); err != nil {
+		return "", "", fmt.Errorf("failed to generate WireGuard private key: %w", err)
+	}
+	// Clamp per the Curve25519/WireGuard key format.
+	priv[0] &= 248
+	priv[31] &= 127
+	priv[31] |= 64
+
+	pub, err := curve25519.X25519(priv[:], curve25519.Basepoint)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to derive WireGuard public key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(priv[:]), base64.StdEncoding.EncodeToString(pub), nil
+}
+
+// reconcileWireGuardKeypairSecret ensures pod has a WireGuard keypair Secret, generating one on
+// first creation and leaving it untouched afterwards; rotation is handled by
+// reconcileClusterBusKeyRotation once Spec.ClusterBus's owner rotates the Secret out-of-band.
+func (r *ValkeyClusterReconciler) reconcileWireGuardKeypairSecret(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster, pod *corev1.Pod) (*corev1.Secret, error) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: wireGuardKeySecretName(cluster, pod.Name), Namespace: cluster.Namespace}}
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+	switch {
+	case err == nil:
+		return secret, nil
+	case apierrors.IsNotFound(err):
+	default:
+		return nil, fmt.Errorf("failed to get WireGuard keypair secret for pod %s: %w", pod.Name, err)
+	}
+
+	privateKey, publicKey, err := generateWireGuardKeypair()
+	if err != nil {
+		return nil, err
+	}
+	secret.Data = map[string][]byte{
+		wireGuardPrivateKeyDataKey: []byte(privateKey),
+		wireGuardPublicKeyDataKey:  []byte(publicKey),
+	}
+	if err := controllerutil.SetControllerReference(cluster, secret, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference on WireGuard keypair secret: %w", err)
+	}
+	if err := r.Client.Create(ctx, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("failed to create WireGuard keypair secret for pod %s: %w", pod.Name, err)
+	}
+	return secret, nil
+}
+
+// wireGuardPeer describes one mesh peer for the init container's `wg set` invocation.
+type wireGuardPeer struct {
+	PublicKey string
+	Address   string
+}
+
+// buildWireGuardInitContainer generates the init container that configures wg0 inside pod before
+// Valkey starts: it brings up the interface with the pod's own keypair and address, then adds
+// every other pod in the cluster as a peer so cluster-bus traffic is encrypted end to end. The
+// private key is read from the keypair Secret mounted at wireGuardKeyMountPath (see
+// reconcileWireGuardKeypairSecret) rather than passed on the command line, so it never appears in
+// the Pod spec, etcd, or audit logs.
+func buildWireGuardInitContainer(podName string, ordinal int, peers []wireGuardPeer) corev1.Container {
+	privateKeyPath := fmt.Sprintf("%s/%s", wireGuardKeyMountPath, wireGuardPrivateKeyDataKey)
+	script := fmt.Sprintf(`set -e
+ip link add %[1]s type wireguard
+wg set %[1]s private-key %[2]s listen-port %[3]d
+ip addr add %[4]s/24 dev %[1]s
+`, wireGuardInterfaceName, privateKeyPath, wireGuardListenPort, wireGuardPodAddress(ordinal))
+	for _, peer := range peers {
+		script += fmt.Sprintf("wg set %s peer %s allowed-ips %s/32\n", wireGuardInterfaceName, peer.PublicKey, peer.Address)
+	}
+	script += fmt.Sprintf("ip link set %s up\n", wireGuardInterfaceName)
+
+	return corev1.Container{
+		Name:    "wireguard-init",
+		Image:   DefaultWireGuardToolsImage,
+		Command: []string{"sh", "-c", script},
+		SecurityContext: &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      wireGuardKeyVolumeName,
+				MountPath: wireGuardKeyMountPath,
+				ReadOnly:  true,
+			},
+		},
+	}
+}
+
+// buildWireGuardKeyVolume returns the Secret-backed Volume the init container mounts its keypair
+// from, sourced from the per-pod Secret reconcileWireGuardKeypairSecret maintains.
+func buildWireGuardKeyVolume(cluster *valkeyiov1alpha1.ValkeyCluster, podName string) corev1.Volume {
+	return corev1.Volume{
+		Name: wireGuardKeyVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: wireGuardKeySecretName(cluster, podName),
+			},
+		},
+	}
+}
+
+// wireGuardClusterAnnounceIP is the cluster-announce-ip valkey.conf directive should use once the
+// cluster bus is encrypted over WireGuard, so peers gossip the mesh address rather than the pod IP.
+func wireGuardClusterAnnounceIP(ordinal int) string {
+	return wireGuardPodAddress(ordinal)
+}
+
+// wireGuardPeerClient applies a new private key to a running pod's wg0 interface without
+// restarting it, so the key rotation reconciler can roll keys out-of-band.
+type wireGuardPeerClient interface {
+	SetPrivateKey(ctx context.Context, privateKey string) error
+}
+
+// reconcileClusterBusKeyRotation re-applies each pod's WireGuard private key whenever its own
+// keypair Secret's resourceVersion changes, without restarting the pod. Every pod has a distinct
+// Secret (see wireGuardKeySecretName), so drift is tracked per pod rather than against a single
+// cluster-wide resourceVersion, matching how reconcileExporterACLRotation in auth.go tracks drift
+// against its one shared Secret.
+func (r *ValkeyClusterReconciler) reconcileClusterBusKeyRotation(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster, podClients map[string]wireGuardPeerClient) error {
+	if clusterBusEncryptionMode(cluster) != valkeyiov1alpha1.ClusterBusEncryptionWireGuard {
+		return nil
+	}
+
+	var observed map[string]string
+	if cluster.Status.ClusterBus != nil {
+		observed = cluster.Status.ClusterBus.ObservedWireGuardSecretResourceVersions
+	}
+
+	latest := make(map[string]string, len(observed))
+	for k, v := range observed {
+		latest[k] = v
+	}
+
+	changed := false
+	for podName, peer := range podClients {
+		secret := &corev1.Secret{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: wireGuardKeySecretName(cluster, podName), Namespace: cluster.Namespace}, secret); err != nil {
+			return fmt.Errorf("failed to get WireGuard keypair secret for pod %s: %w", podName, err)
+		}
+		if observed[podName] == secret.ResourceVersion {
+			continue
+		}
+		if err := peer.SetPrivateKey(ctx, string(secret.Data[wireGuardPrivateKeyDataKey])); err != nil {
+			return fmt.Errorf("failed to rotate WireGuard key for pod %s: %w", podName, err)
+		}
+		latest[podName] = secret.ResourceVersion
+		changed = true
+	}
+
+	if changed {
+		if cluster.Status.ClusterBus == nil {
+			cluster.Status.ClusterBus = &valkeyiov1alpha1.ClusterBusStatus{}
+		}
+		cluster.Status.ClusterBus.ObservedWireGuardSecretResourceVersions = latest
+		log.FromContext(ctx).Info("rotated cluster bus WireGuard keys", "podCount", len(podClients))
+	}
+	return nil
+}
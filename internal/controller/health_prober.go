@@ -0,0 +1,291 @@
+/*
+Copyright 2025 Valkey Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	valkeyiov1alpha1 "valkey.io/valkey-operator/api/v1alpha1"
+)
+
+const (
+	// readyConditionType is the status condition the health prober and the rest of the cluster
+	// controller drive.
+	readyConditionType = "Ready"
+
+	// clusterHealthProbeMaxAttempts and clusterHealthProbeBaseDelay bound the retry budget the
+	// prober spends on a single cluster before flipping Ready=False, matching the roughly
+	// 15-attempts-times-10-seconds pattern used by etcdctl cluster-health in comparable
+	// clustered-store operators.
+	clusterHealthProbeMaxAttempts = 15
+	clusterHealthProbeBaseDelay   = 10 * time.Second
+
+	defaultClusterHealthProbeInterval = 30 * time.Second
+)
+
+// Ready condition reasons reported by the ClusterHealthProber.
+const (
+	ReasonClusterHealthy  = "ClusterHealthy"
+	ReasonSlotsUnassigned = "SlotsUnassigned"
+	ReasonNodeLinkDown    = "NodeLinkDown"
+	ReasonQuorumLost      = "QuorumLost"
+)
+
+// valkeyClusterInfoClient issues read-only cluster introspection commands against a single Valkey
+// node, honoring whatever TLS material the cluster requires.
+type valkeyClusterInfoClient interface {
+	ClusterInfo(ctx context.Context) (string, error)
+	ClusterNodes(ctx context.Context) (string, error)
+	Close() error
+}
+
+// ClusterHealthProber periodically polls CLUSTER INFO/CLUSTER NODES on every pod of every
+// ValkeyCluster and drives the Ready status condition between ClusterHealthy, SlotsUnassigned,
+// NodeLinkDown, and QuorumLost, emitting a Kubernetes Event on every transition. It implements
+// manager.Runnable so it runs for the lifetime of the operator process alongside the reconciler.
+type ClusterHealthProber struct {
+	Client   client.Client
+	Recorder record.EventRecorder
+	Interval time.Duration
+
+	// Dial opens a connection to pod's DefaultPort, honoring the same TLS material
+	// generateMetricsExporterContainerDef resolves via getTLSFileNames. Required.
+	Dial func(ctx context.Context, pod *corev1.Pod, cluster *valkeyiov1alpha1.ValkeyCluster) (valkeyClusterInfoClient, error)
+}
+
+// Start implements manager.Runnable, probing every known ValkeyCluster every Interval until ctx is
+// cancelled.
+func (p *ClusterHealthProber) Start(ctx context.Context) error {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = defaultClusterHealthProbeInterval
+	}
+	wait.UntilWithContext(ctx, p.probeAll, interval)
+	return nil
+}
+
+func (p *ClusterHealthProber) probeAll(ctx context.Context) {
+	var clusters valkeyiov1alpha1.ValkeyClusterList
+	if err := p.Client.List(ctx, &clusters); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list ValkeyClusters for cluster-health probe")
+		return
+	}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if err := p.probeCluster(ctx, cluster); err != nil {
+			log.FromContext(ctx).Error(err, "cluster-health probe failed", "cluster", cluster.Name)
+		}
+	}
+}
+
+func (p *ClusterHealthProber) probeCluster(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster) error {
+	var pods corev1.PodList
+	if err := p.Client.List(ctx, &pods, client.InNamespace(cluster.Namespace), client.MatchingLabels(exporterSelectorLabels(cluster))); err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	var shards []valkeyiov1alpha1.ShardStatus
+	reason := ReasonClusterHealthy
+	message := "cluster_state:ok, all slots assigned, all node links up"
+
+	probeErr := retryWithBackoff(ctx, clusterHealthProbeMaxAttempts, clusterHealthProbeBaseDelay, func() error {
+		shards = nil
+		if len(pods.Items) == 0 {
+			reason, message = ReasonQuorumLost, fmt.Sprintf("no pods found matching cluster %s", cluster.Name)
+			return fmt.Errorf("%s", message)
+		}
+		var firstErr error
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			shard, failReason, failMessage, err := p.probePod(ctx, cluster, pod)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					reason, message = failReason, failMessage
+				}
+				shards = append(shards, valkeyiov1alpha1.ShardStatus{Name: pod.Name, Healthy: false})
+				continue
+			}
+			shards = append(shards, shard)
+		}
+		if firstErr != nil {
+			return firstErr
+		}
+		reason, message = ReasonClusterHealthy, "cluster_state:ok, all slots assigned, all node links up"
+		return nil
+	})
+
+	status := metav1.ConditionTrue
+	if probeErr != nil {
+		status = metav1.ConditionFalse
+	}
+	return p.updateStatus(ctx, cluster, shards, status, reason, message)
+}
+
+// probePod issues CLUSTER INFO and CLUSTER NODES against a single pod and classifies the result.
+func (p *ClusterHealthProber) probePod(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster, pod *corev1.Pod) (valkeyiov1alpha1.ShardStatus, string, string, error) {
+	node, err := p.Dial(ctx, pod, cluster)
+	if err != nil {
+		return valkeyiov1alpha1.ShardStatus{}, ReasonQuorumLost, fmt.Sprintf("failed to connect to pod %s: %v", pod.Name, err), err
+	}
+	defer node.Close()
+
+	info, err := node.ClusterInfo(ctx)
+	if err != nil {
+		return valkeyiov1alpha1.ShardStatus{}, ReasonQuorumLost, fmt.Sprintf("CLUSTER INFO against pod %s failed: %v", pod.Name, err), err
+	}
+	nodes, err := node.ClusterNodes(ctx)
+	if err != nil {
+		return valkeyiov1alpha1.ShardStatus{}, ReasonQuorumLost, fmt.Sprintf("CLUSTER NODES against pod %s failed: %v", pod.Name, err), err
+	}
+
+	state, assigned, ok, err := parseClusterInfo(info)
+	if err != nil {
+		return valkeyiov1alpha1.ShardStatus{}, ReasonQuorumLost, err.Error(), err
+	}
+	if state != "ok" {
+		err := fmt.Errorf("pod %s reports cluster_state:%s", pod.Name, state)
+		return valkeyiov1alpha1.ShardStatus{}, ReasonQuorumLost, err.Error(), err
+	}
+	if assigned != ok {
+		err := fmt.Errorf("pod %s has %d/%d slots assigned", pod.Name, ok, assigned)
+		return valkeyiov1alpha1.ShardStatus{}, ReasonSlotsUnassigned, err.Error(), err
+	}
+	if down := findDownLinks(nodes); len(down) > 0 {
+		err := fmt.Errorf("pod %s reports down links: %s", pod.Name, strings.Join(down, ", "))
+		return valkeyiov1alpha1.ShardStatus{}, ReasonNodeLinkDown, err.Error(), err
+	}
+
+	return valkeyiov1alpha1.ShardStatus{Name: pod.Name, Healthy: true, AssignedSlots: assigned}, "", "", nil
+}
+
+var (
+	clusterStateRe         = regexp.MustCompile(`cluster_state:(\w+)`)
+	clusterSlotsAssignedRe = regexp.MustCompile(`cluster_slots_assigned:(\d+)`)
+	clusterSlotsOkRe       = regexp.MustCompile(`cluster_slots_ok:(\d+)`)
+)
+
+// parseClusterInfo extracts cluster_state, cluster_slots_assigned, and cluster_slots_ok from a
+// CLUSTER INFO reply.
+func parseClusterInfo(info string) (state string, assignedSlots, okSlots int32, err error) {
+	stateMatch := clusterStateRe.FindStringSubmatch(info)
+	if stateMatch == nil {
+		return "", 0, 0, fmt.Errorf("CLUSTER INFO response missing cluster_state")
+	}
+
+	assignedMatch := clusterSlotsAssignedRe.FindStringSubmatch(info)
+	if assignedMatch == nil {
+		return "", 0, 0, fmt.Errorf("CLUSTER INFO response missing cluster_slots_assigned")
+	}
+	assigned, err := strconv.ParseInt(assignedMatch[1], 10, 32)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("invalid cluster_slots_assigned: %w", err)
+	}
+
+	okMatch := clusterSlotsOkRe.FindStringSubmatch(info)
+	if okMatch == nil {
+		return "", 0, 0, fmt.Errorf("CLUSTER INFO response missing cluster_slots_ok")
+	}
+	ok, err := strconv.ParseInt(okMatch[1], 10, 32)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("invalid cluster_slots_ok: %w", err)
+	}
+
+	return stateMatch[1], int32(assigned), int32(ok), nil
+}
+
+// findDownLinks returns the node IDs reported by CLUSTER NODES whose link-state field isn't
+// "connected".
+func findDownLinks(nodesOutput string) []string {
+	var down []string
+	for _, line := range strings.Split(strings.TrimSpace(nodesOutput), "\n") {
+		fields := strings.Fields(line)
+		// id, addr, flags, master, ping-sent, pong-recv, config-epoch, link-state, [slots...]
+		const linkStateField = 7
+		if len(fields) <= linkStateField {
+			continue
+		}
+		if fields[linkStateField] != "connected" {
+			down = append(down, fields[0])
+		}
+	}
+	return down
+}
+
+// retryWithBackoff calls fn up to maxAttempts times on a fixed interval seeded at baseDelay,
+// returning fn's last error if every attempt fails. The interval is deliberately not allowed to
+// grow past baseDelay (Factor: 1): an uncapped exponential backoff here would balloon the
+// maxAttempts-times-baseDelay retry budget the caller sizes Ready=False transitions around into
+// hours.
+func retryWithBackoff(ctx context.Context, maxAttempts int, baseDelay time.Duration, fn func() error) error {
+	backoff := wait.Backoff{
+		Duration: baseDelay,
+		Factor:   1,
+		Jitter:   0.1,
+		Steps:    maxAttempts,
+	}
+
+	var lastErr error
+	_ = wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		lastErr = fn()
+		return lastErr == nil, nil
+	})
+	return lastErr
+}
+
+// updateStatus persists shards and the Ready condition, emitting a Kubernetes Event whenever the
+// Ready condition's Reason changes so `kubectl describe` shows the health trajectory.
+func (p *ClusterHealthProber) updateStatus(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster, shards []valkeyiov1alpha1.ShardStatus, status metav1.ConditionStatus, reason, message string) error {
+	previous := apimeta.FindStatusCondition(cluster.Status.Conditions, readyConditionType)
+	// SetStatusCondition updates the existing condition in place, so compare before calling it.
+	reasonChanged := previous == nil || previous.Reason != reason
+
+	cluster.Status.Shards = shards
+	apimeta.SetStatusCondition(&cluster.Status.Conditions, metav1.Condition{
+		Type:               readyConditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cluster.Generation,
+	})
+
+	if err := p.Client.Status().Update(ctx, cluster); err != nil {
+		return fmt.Errorf("failed to update ValkeyCluster status: %w", err)
+	}
+
+	if p.Recorder != nil && reasonChanged {
+		eventType := corev1.EventTypeNormal
+		if status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		p.Recorder.Event(cluster, eventType, reason, message)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 Valkey Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseClusterInfoHealthy(t *testing.T) {
+	info := "cluster_state:ok\ncluster_slots_assigned:16384\ncluster_slots_ok:16384\ncluster_known_nodes:3\n"
+
+	state, assigned, ok, err := parseClusterInfo(info)
+	if err != nil {
+		t.Fatalf("parseClusterInfo() returned error: %v", err)
+	}
+	if state != "ok" {
+		t.Errorf("expected state %q, got %q", "ok", state)
+	}
+	if assigned != 16384 || ok != 16384 {
+		t.Errorf("expected assigned=ok=16384, got assigned=%d ok=%d", assigned, ok)
+	}
+}
+
+func TestParseClusterInfoMissingField(t *testing.T) {
+	if _, _, _, err := parseClusterInfo("cluster_state:ok\n"); err == nil {
+		t.Fatal("expected an error when cluster_slots_assigned is missing, got nil")
+	}
+}
+
+func TestFindDownLinksReportsOnlyDisconnected(t *testing.T) {
+	nodes := `07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 slave 67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1 0 1426238317239 4 connected
+67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1 127.0.0.1:30002@31002 master - 0 1426238316232 2 disconnected 5461-10922
+292f8b365bb7edb5e285caf0b7e6ddc7265d2f4f 127.0.0.1:30003@31003 master - 0 1426238318243 3 connected 10923-16383
+`
+
+	down := findDownLinks(nodes)
+	if len(down) != 1 || down[0] != "67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1" {
+		t.Errorf("expected exactly the disconnected node, got %v", down)
+	}
+}
+
+func TestFindDownLinksAllConnected(t *testing.T) {
+	nodes := `07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 slave 67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1 0 1426238317239 4 connected
+`
+	if down := findDownLinks(nodes); len(down) != 0 {
+		t.Errorf("expected no down links, got %v", down)
+	}
+}
+
+func TestRetryWithBackoffSucceedsEventually(t *testing.T) {
+	attempts := 0
+	err := retryWithBackoff(context.Background(), 5, time.Millisecond, func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("not yet")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected eventual success, got error: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected exactly 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryWithBackoffReturnsLastErrorAfterExhaustingAttempts(t *testing.T) {
+	attempts := 0
+	wantErr := errors.New("persistent failure")
+	err := retryWithBackoff(context.Background(), 3, time.Millisecond, func() error {
+		attempts++
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected exactly maxAttempts=3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryWithBackoffIsBoundedNotExponential(t *testing.T) {
+	const maxAttempts = 15
+	start := time.Now()
+	_ = retryWithBackoff(context.Background(), maxAttempts, 10*time.Millisecond, func() error {
+		return errors.New("always fails")
+	})
+	elapsed := time.Since(start)
+
+	// A fixed interval of 10ms across maxAttempts-1=14 waits comes to about 140ms; an uncapped
+	// Factor:1.5 exponential backoff at this base delay would instead take nearly 6 seconds, so
+	// this also guards against the backoff regressing to unbounded growth.
+	if elapsed > time.Second {
+		t.Errorf("expected retryWithBackoff to stay on a fixed, bounded interval, took %s", elapsed)
+	}
+}
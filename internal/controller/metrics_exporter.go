@@ -18,16 +18,31 @@ package controller
 
 import (
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	valkeyiov1alpha1 "valkey.io/valkey-operator/api/v1alpha1"
 )
 
+const (
+	// exporterServerTLSCertMountPath is where the exporter's own serving certificate (from
+	// Spec.Exporter.TLS.ServerCertSecret) is mounted, distinct from tlsCertMountPath which holds
+	// the certificate the exporter presents as a client to the Valkey server.
+	exporterServerTLSCertMountPath = "/etc/valkey-exporter/tls"
+	tlsCertFileName                = "tls.crt"
+	tlsKeyFileName                 = "tls.key"
+	tlsCAFileName                  = "ca.crt"
+
+	// exporterScriptMountPath is where Spec.Exporter.ScriptConfigMapRef's Lua scripts are mounted.
+	exporterScriptMountPath = "/scripts"
+)
+
 // getExporterEnvironmentVariables returns the environment variables for the Redis Exporter container.
 // The Redis address is set to the Redis host and port, and TLS configuration is set if enabled.
-// The function returns list of environment variables.
-func getExporterEnvironmentVariables(valkeyName string, tlsEnabled bool, certPath, keyPath, caPath string) []corev1.EnvVar {
+// insecureSkipVerify must be explicitly opted into by the user; the function never sets
+// REDIS_EXPORTER_SKIP_TLS_VERIFICATION on its own. The function returns list of environment variables.
+func getExporterEnvironmentVariables(valkeyName string, tlsEnabled, insecureSkipVerify bool, certPath, keyPath, caPath string) []corev1.EnvVar {
 	var envVars []corev1.EnvVar
 	redisHost := "redis://localhost"
 	if tlsEnabled {
@@ -36,10 +51,12 @@ func getExporterEnvironmentVariables(valkeyName string, tlsEnabled bool, certPat
 			Name:  "REDIS_EXPORTER_TLS_CA_CERT_FILE",
 			Value: caPath,
 		})
-		envVars = append(envVars, corev1.EnvVar{
-			Name:  "REDIS_EXPORTER_SKIP_TLS_VERIFICATION",
-			Value: "true",
-		})
+		if insecureSkipVerify {
+			envVars = append(envVars, corev1.EnvVar{
+				Name:  "REDIS_EXPORTER_SKIP_TLS_VERIFICATION",
+				Value: "true",
+			})
+		}
 		envVars = append(envVars, corev1.EnvVar{
 			Name:  "REDIS_EXPORTER_TLS_CLIENT_CERT_FILE",
 			Value: certPath,
@@ -63,8 +80,21 @@ func getExporterEnvironmentVariables(valkeyName string, tlsEnabled bool, certPat
 	return envVars
 }
 
-// generateMetricsExporterContainerDef generates the container definition for the metrics exporter sidecar.
-func generateMetricsExporterContainerDef(cluster *valkeyiov1alpha1.ValkeyCluster) corev1.Container {
+// getExporterServerTLSEnvironmentVariables returns the environment variables that make
+// redis_exporter serve /metrics over HTTPS using the certificate mounted at exporterServerTLSCertMountPath.
+func getExporterServerTLSEnvironmentVariables(certPath, keyPath, caPath string) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: "REDIS_EXPORTER_TLS_SERVER_CERT_FILE", Value: certPath},
+		{Name: "REDIS_EXPORTER_TLS_SERVER_KEY_FILE", Value: keyPath},
+		{Name: "REDIS_EXPORTER_TLS_SERVER_CA_CERT_FILE", Value: caPath},
+	}
+}
+
+// generateMetricsExporterContainerDef generates the container definition for the metrics exporter
+// sidecar. scriptFileNames lists the keys of Spec.Exporter.ScriptConfigMapRef as already resolved
+// by the caller (each key is mounted as a file under exporterScriptMountPath); it is ignored when
+// ScriptConfigMapRef is unset.
+func generateMetricsExporterContainerDef(cluster *valkeyiov1alpha1.ValkeyCluster, scriptFileNames []string) corev1.Container {
 	exporterImage := DefaultExporterImage
 	if cluster.Spec.Exporter.Image != "" {
 		exporterImage = cluster.Spec.Exporter.Image
@@ -81,18 +111,52 @@ func generateMetricsExporterContainerDef(cluster *valkeyiov1alpha1.ValkeyCluster
 	}
 
 	if tlsEnabled {
-		volumeMounts = []corev1.VolumeMount{
-			{
-				Name:      "tls-certs",
-				MountPath: tlsCertMountPath,
-				ReadOnly:  true,
-			},
-		}
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "tls-certs",
+			MountPath: tlsCertMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	exporterTLS := cluster.Spec.Exporter.TLS
+	serverTLSEnabled := exporterTLS != nil && exporterTLS.Enabled
+	insecureSkipVerify := exporterTLS != nil && exporterTLS.InsecureSkipVerify
+	var serverCertPath, serverKeyPath, serverCAPath string
+	if serverTLSEnabled {
+		serverCertPath = fmt.Sprintf("%s/%s", exporterServerTLSCertMountPath, tlsCertFileName)
+		serverKeyPath = fmt.Sprintf("%s/%s", exporterServerTLSCertMountPath, tlsKeyFileName)
+		serverCAPath = fmt.Sprintf("%s/%s", exporterServerTLSCertMountPath, tlsCAFileName)
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "exporter-server-tls",
+			MountPath: exporterServerTLSCertMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	envVars := getExporterEnvironmentVariables(cluster.Name, tlsEnabled, insecureSkipVerify, certPath, keyPath, caPath)
+	if serverTLSEnabled {
+		envVars = append(envVars, getExporterServerTLSEnvironmentVariables(serverCertPath, serverKeyPath, serverCAPath)...)
 	}
-	envVars := getExporterEnvironmentVariables(cluster.Name, tlsEnabled, certPath, keyPath, caPath)
+	envVars = append(envVars, getExporterAuthEnvironmentVariables(cluster)...)
+	envVars = append(envVars, mergeExtraEnvVars(cluster.Spec.Exporter.ExtraEnv)...)
+
+	if cluster.Spec.Exporter.ScriptConfigMapRef != nil {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "exporter-scripts",
+			MountPath: exporterScriptMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	probeScheme := corev1.URISchemeHTTP
+	if serverTLSEnabled {
+		probeScheme = corev1.URISchemeHTTPS
+	}
+
 	return corev1.Container{
 		Name:         "metrics-exporter",
 		Image:        exporterImage,
+		Args:         buildExporterArgs(cluster, scriptFileNames),
 		Env:          envVars,
 		VolumeMounts: volumeMounts,
 		Ports: []corev1.ContainerPort{
@@ -108,8 +172,9 @@ func generateMetricsExporterContainerDef(cluster *valkeyiov1alpha1.ValkeyCluster
 			TimeoutSeconds:      3,
 			ProbeHandler: corev1.ProbeHandler{
 				HTTPGet: &corev1.HTTPGetAction{
-					Path: "/health",
-					Port: intstr.FromInt(DefaultExporterPort),
+					Path:   "/health",
+					Port:   intstr.FromInt(DefaultExporterPort),
+					Scheme: probeScheme,
 				},
 			},
 		},
@@ -119,11 +184,83 @@ func generateMetricsExporterContainerDef(cluster *valkeyiov1alpha1.ValkeyCluster
 			TimeoutSeconds:      3,
 			ProbeHandler: corev1.ProbeHandler{
 				HTTPGet: &corev1.HTTPGetAction{
-					Path: "/health",
-					Port: intstr.FromInt(DefaultExporterPort),
+					Path:   "/health",
+					Port:   intstr.FromInt(DefaultExporterPort),
+					Scheme: probeScheme,
 				},
 			},
 		},
 		Resources: cluster.Spec.Exporter.Resources,
 	}
 }
+
+// buildExporterServerTLSVolume is the Secret-backed Volume the exporter container mounts its
+// serving certificate from at exporterServerTLSCertMountPath, sourced from
+// Spec.Exporter.TLS.ServerCertSecret. Only meaningful when serverTLSEnabled.
+func buildExporterServerTLSVolume(cluster *valkeyiov1alpha1.ValkeyCluster) corev1.Volume {
+	return corev1.Volume{
+		Name: "exporter-server-tls",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: cluster.Spec.Exporter.TLS.ServerCertSecret,
+			},
+		},
+	}
+}
+
+// buildExporterScriptsVolume is the ConfigMap-backed Volume the exporter container mounts its Lua
+// scripts from at exporterScriptMountPath, sourced from Spec.Exporter.ScriptConfigMapRef.
+func buildExporterScriptsVolume(cluster *valkeyiov1alpha1.ValkeyCluster) corev1.Volume {
+	return corev1.Volume{
+		Name: "exporter-scripts",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: *cluster.Spec.Exporter.ScriptConfigMapRef,
+			},
+		},
+	}
+}
+
+// buildExporterArgs builds the redis_exporter command-line flags derived from CheckKeys,
+// CheckSingleKeys, CheckStreams, and ScriptConfigMapRef, followed by the user's own ExtraArgs.
+func buildExporterArgs(cluster *valkeyiov1alpha1.ValkeyCluster, scriptFileNames []string) []string {
+	exporter := cluster.Spec.Exporter
+	var args []string
+	if len(exporter.CheckKeys) > 0 {
+		args = append(args, "--check-keys="+strings.Join(exporter.CheckKeys, ","))
+	}
+	if len(exporter.CheckSingleKeys) > 0 {
+		args = append(args, "--check-single-keys="+strings.Join(exporter.CheckSingleKeys, ","))
+	}
+	if len(exporter.CheckStreams) > 0 {
+		args = append(args, "--check-streams="+strings.Join(exporter.CheckStreams, ","))
+	}
+	if exporter.ScriptConfigMapRef != nil && len(scriptFileNames) > 0 {
+		args = append(args, "--script="+joinScriptPaths(scriptFileNames))
+	}
+	return append(args, exporter.ExtraArgs...)
+}
+
+// joinScriptPaths renders the mounted script file names as the comma-separated absolute paths
+// redis_exporter's --script flag expects.
+func joinScriptPaths(scriptFileNames []string) string {
+	paths := make([]string, len(scriptFileNames))
+	for i, name := range scriptFileNames {
+		paths[i] = fmt.Sprintf("%s/%s", exporterScriptMountPath, name)
+	}
+	return strings.Join(paths, ",")
+}
+
+// mergeExtraEnvVars filters Spec.Exporter.ExtraEnv down to names that don't collide with a
+// variable the controller already sets on the exporter container. The validating webhook rejects
+// such collisions at admission time; this is a defensive second layer.
+func mergeExtraEnvVars(extraEnv []corev1.EnvVar) []corev1.EnvVar {
+	var merged []corev1.EnvVar
+	for _, env := range extraEnv {
+		if _, reserved := valkeyiov1alpha1.ReservedExporterEnvNames[env.Name]; reserved {
+			continue
+		}
+		merged = append(merged, env)
+	}
+	return merged
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2025 Valkey Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	valkeyiov1alpha1 "valkey.io/valkey-operator/api/v1alpha1"
+)
+
+// serviceMonitorGVK and podMonitorGVK identify the Prometheus Operator CRDs we probe for before
+// attempting to create either resource, so the operator degrades gracefully on clusters that
+// don't run Prometheus Operator.
+var (
+	serviceMonitorGVK = schema.GroupVersionKind{Group: monitoringv1.SchemeGroupVersion.Group, Version: monitoringv1.SchemeGroupVersion.Version, Kind: monitoringv1.ServiceMonitorsKind}
+	podMonitorGVK     = schema.GroupVersionKind{Group: monitoringv1.SchemeGroupVersion.Group, Version: monitoringv1.SchemeGroupVersion.Version, Kind: monitoringv1.PodMonitorsKind}
+)
+
+// reconcilePrometheusServiceMonitor creates or updates the ServiceMonitor (or PodMonitor) that
+// makes the exporter sidecar's metrics port discoverable to a Prometheus Operator instance. It is
+// a no-op when the feature isn't requested on the ValkeyCluster, or when the Prometheus Operator
+// CRDs aren't installed on the cluster.
+func (r *ValkeyClusterReconciler) reconcilePrometheusServiceMonitor(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster) error {
+	sm := cluster.Spec.Exporter.ServiceMonitor
+	if sm == nil || !sm.Enabled {
+		return nil
+	}
+
+	gvk := serviceMonitorGVK
+	if sm.UsePodMonitor {
+		gvk = podMonitorGVK
+	}
+	if _, err := r.Client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			log.FromContext(ctx).Info("Prometheus Operator CRDs not found, skipping metrics discovery resource", "kind", gvk.Kind)
+			return nil
+		}
+		return fmt.Errorf("failed to check for %s CRD: %w", gvk.Kind, err)
+	}
+
+	if sm.UsePodMonitor {
+		return r.reconcilePodMonitor(ctx, cluster, sm)
+	}
+	return r.reconcileServiceMonitor(ctx, cluster, sm)
+}
+
+func (r *ValkeyClusterReconciler) reconcileServiceMonitor(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster, sm *valkeyiov1alpha1.ServiceMonitorSpec) error {
+	desired := &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      exporterMonitorName(cluster),
+			Namespace: cluster.Namespace,
+		},
+	}
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, desired, func() error {
+		desired.Labels = mergeStringMaps(exporterSelectorLabels(cluster), sm.AdditionalLabels)
+		desired.Spec = monitoringv1.ServiceMonitorSpec{
+			Selector: metav1.LabelSelector{MatchLabels: exporterSelectorLabels(cluster)},
+			Endpoints: []monitoringv1.Endpoint{
+				buildExporterMetricsEndpoint(cluster, sm),
+			},
+		}
+		return controllerutil.SetControllerReference(cluster, desired, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile ServiceMonitor %s/%s: %w", desired.Namespace, desired.Name, err)
+	}
+	if op != controllerutil.OperationResultNone {
+		log.FromContext(ctx).Info("reconciled ServiceMonitor", "name", desired.Name, "operation", op)
+	}
+	return nil
+}
+
+func (r *ValkeyClusterReconciler) reconcilePodMonitor(ctx context.Context, cluster *valkeyiov1alpha1.ValkeyCluster, sm *valkeyiov1alpha1.ServiceMonitorSpec) error {
+	desired := &monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      exporterMonitorName(cluster),
+			Namespace: cluster.Namespace,
+		},
+	}
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, desired, func() error {
+		desired.Labels = mergeStringMaps(exporterSelectorLabels(cluster), sm.AdditionalLabels)
+		endpoint := buildExporterMetricsEndpoint(cluster, sm)
+		desired.Spec = monitoringv1.PodMonitorSpec{
+			Selector: metav1.LabelSelector{MatchLabels: exporterSelectorLabels(cluster)},
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+				{
+					Port:           endpoint.Port,
+					Path:           endpoint.Path,
+					Scheme:         endpoint.Scheme,
+					Interval:       endpoint.Interval,
+					ScrapeTimeout:  endpoint.ScrapeTimeout,
+					HonorLabels:    endpoint.HonorLabels,
+					RelabelConfigs: endpoint.RelabelConfigs,
+				},
+			},
+		}
+		return controllerutil.SetControllerReference(cluster, desired, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile PodMonitor %s/%s: %w", desired.Namespace, desired.Name, err)
+	}
+	if op != controllerutil.OperationResultNone {
+		log.FromContext(ctx).Info("reconciled PodMonitor", "name", desired.Name, "operation", op)
+	}
+	return nil
+}
+
+// buildExporterMetricsEndpoint builds the scrape endpoint shared by the ServiceMonitor and
+// PodMonitor variants, including the relabel rules needed to preserve shard/replica identity once
+// Prometheus overwrites the pod-level labels with its own target labels, and the tlsConfig block
+// when the exporter's /metrics endpoint is served over HTTPS (cluster.Spec.Exporter.TLS, not
+// Spec.TLS which only covers client-facing connections to Valkey itself).
+func buildExporterMetricsEndpoint(cluster *valkeyiov1alpha1.ValkeyCluster, sm *valkeyiov1alpha1.ServiceMonitorSpec) monitoringv1.Endpoint {
+	endpoint := monitoringv1.Endpoint{
+		Port:           "metrics",
+		Path:           "/metrics",
+		HonorLabels:    sm.HonorLabels,
+		RelabelConfigs: shardIdentityRelabelConfigs(),
+	}
+	if sm.Interval != "" {
+		endpoint.Interval = monitoringv1.Duration(sm.Interval)
+	}
+	if sm.ScrapeTimeout != "" {
+		endpoint.ScrapeTimeout = monitoringv1.Duration(sm.ScrapeTimeout)
+	}
+
+	exporterTLS := cluster.Spec.Exporter.TLS
+	if exporterTLS != nil && exporterTLS.Enabled {
+		secretName := exporterTLS.ServerCertSecret
+		endpoint.Scheme = "https"
+		endpoint.TLSConfig = &monitoringv1.TLSConfig{
+			SafeTLSConfig: monitoringv1.SafeTLSConfig{
+				CA:        monitoringv1.SecretOrConfigMap{Secret: secretKeySelector(secretName, tlsCAFileName)},
+				Cert:      monitoringv1.SecretOrConfigMap{Secret: secretKeySelector(secretName, tlsCertFileName)},
+				KeySecret: secretKeySelector(secretName, tlsKeyFileName),
+			},
+		}
+	}
+	return endpoint
+}
+
+// secretKeySelector builds a reference to key within the Secret named name, mirroring the secret
+// already mounted into the exporter sidecar so the ServiceMonitor's scrape client trusts the same
+// certificate material.
+func secretKeySelector(name, key string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  key,
+	}
+}
+
+// shardIdentityRelabelConfigs rewrites the Prometheus target labels Kubernetes service discovery
+// produces so the shard and replica identity encoded in the pod's valkey.io/shard and
+// valkey.io/role labels survives scraping.
+func shardIdentityRelabelConfigs() []monitoringv1.RelabelConfig {
+	return []monitoringv1.RelabelConfig{
+		{
+			SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_valkey_io_shard"},
+			TargetLabel:  "shard",
+		},
+		{
+			SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_valkey_io_role"},
+			TargetLabel:  "role",
+		},
+		{
+			SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_name"},
+			TargetLabel:  "pod",
+		},
+	}
+}
+
+// exporterMonitorName is the name given to the generated ServiceMonitor/PodMonitor.
+func exporterMonitorName(cluster *valkeyiov1alpha1.ValkeyCluster) string {
+	return cluster.Name + "-exporter"
+}
+
+// exporterSelectorLabels are the labels placed on every pod belonging to cluster, used to target
+// the generated ServiceMonitor/PodMonitor at the right pods.
+func exporterSelectorLabels(cluster *valkeyiov1alpha1.ValkeyCluster) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/instance":  cluster.Name,
+		"app.kubernetes.io/component": "metrics-exporter",
+	}
+}
+
+// mergeStringMaps returns a new map containing the union of base and extra, with extra's values
+// taking precedence on key collision.
+func mergeStringMaps(base, extra map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(extra))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range extra {
+		merged[k] = v
+	}
+	return merged
+}
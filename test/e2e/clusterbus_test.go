@@ -0,0 +1,113 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2025 Valkey Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"valkey.io/valkey-operator/test/utils"
+)
+
+var _ = Describe("Valkey Cluster Bus Encryption", Label("clusterbus"), func() {
+	AfterEach(func() {
+		specReport := CurrentSpecReport()
+		if specReport.Failed() {
+			utils.CollectDebugInfo(namespace)
+		}
+	})
+
+	Context("WireGuard encryption is enabled", func() {
+		It("should reach ClusterHealthy and carry no plaintext PING/PONG on the bus port", func() {
+			By("creating a ValkeyCluster with the cluster bus encrypted over WireGuard")
+			valkeyName := "valkey-cluster-bus-wireguard"
+			valkeyYaml := fmt.Sprintf(`
+apiVersion: valkey.io/v1alpha1
+kind: ValkeyCluster
+metadata:
+  name: %s
+spec:
+  shards: 3
+  replicas: 1
+  clusterBus:
+    encryption: wireguard
+`, valkeyName)
+
+			manifestFile := filepath.Join(os.TempDir(), fmt.Sprintf("%s.yaml", valkeyName))
+			err := os.WriteFile(manifestFile, []byte(valkeyYaml), 0644)
+			Expect(err).NotTo(HaveOccurred(), "Failed to write manifest file")
+			defer os.Remove(manifestFile)
+
+			cmd := exec.Command("kubectl", "create", "-f", manifestFile)
+			_, err = utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to create ValkeyCluster CR")
+			defer func() {
+				cmd := exec.Command("kubectl", "delete", "valkeycluster", valkeyName, "--ignore-not-found=true")
+				utils.Run(cmd)
+			}()
+
+			By("validating that the pods are running")
+			Eventually(func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "pods",
+					"-l", fmt.Sprintf("app.kubernetes.io/instance=%s", valkeyName),
+					"-o", "jsonpath={.items[*].status.phase}",
+				)
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(ContainSubstring("Running"))
+			}).Should(Succeed())
+
+			By("validating the status condition ClusterHealthy")
+			Eventually(func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "valkeycluster", valkeyName,
+					"-o", "jsonpath={.status.conditions[?(@.type=='Ready')].reason}",
+				)
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(ContainSubstring("ClusterHealthy"))
+			}, "3m", "5s").Should(Succeed())
+
+			By("Getting the first pod name for a bus-port tcpdump")
+			var podName string
+			Eventually(func(g Gomega) {
+				args := []string{
+					"get", "pods", "-l", "app.kubernetes.io/instance=" + valkeyName,
+					"-o", "jsonpath={.items[0].metadata.name}",
+				}
+				cmd := exec.Command("kubectl", args...)
+				out, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred(), "Failed to get pod name")
+				g.Expect(out).NotTo(BeEmpty(), "Pod name should not be empty")
+				podName = out
+			}).Should(Succeed())
+
+			By("verifying a tcpdump on the cluster bus port yields no plaintext PING/PONG frames")
+			cmd = exec.Command("kubectl", "exec", podName, "--",
+				"timeout", "10", "tcpdump", "-i", "any", "-c", "20", "-A", "port", "16379")
+			out, _ := utils.Run(cmd)
+			Expect(out).NotTo(ContainSubstring("PING"), "cluster bus traffic should not contain plaintext PING frames")
+			Expect(out).NotTo(ContainSubstring("PONG"), "cluster bus traffic should not contain plaintext PONG frames")
+		})
+	})
+})
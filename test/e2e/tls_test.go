@@ -225,6 +225,134 @@ spec:
 			}).Should(Succeed())
 		})
 
+		It("should serve /metrics over HTTPS when the exporter's TLS is enabled", func() {
+			By("creating a cert-manager Issuer and Certificate for the exporter")
+			issuerYaml := `
+apiVersion: cert-manager.io/v1
+kind: Issuer
+metadata:
+  name: selfsigned-issuer
+  namespace: default
+spec:
+  selfSigned: {}
+---
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: valkey-exporter-cert
+  namespace: default
+spec:
+  secretName: valkey-exporter-tls
+  issuerRef:
+    name: selfsigned-issuer
+    kind: Issuer
+  commonName: valkey-exporter
+  dnsNames:
+  - localhost
+`
+			issuerFile := filepath.Join(os.TempDir(), "exporter-issuer.yaml")
+			err := os.WriteFile(issuerFile, []byte(issuerYaml), 0644)
+			Expect(err).NotTo(HaveOccurred(), "Failed to write issuer manifest")
+			defer os.Remove(issuerFile)
+
+			Eventually(func(g Gomega) {
+				cmd := exec.Command("kubectl", "apply", "-f", issuerFile)
+				_, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred(), "Failed to create Issuer and Certificate")
+			}).Should(Succeed())
+			defer func() {
+				cmd := exec.Command("kubectl", "delete", "-f", issuerFile, "--ignore-not-found=true")
+				utils.Run(cmd)
+			}()
+
+			By("waiting for Certificate to be ready and Secret to be created")
+			Eventually(func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "secret", "valkey-exporter-tls", "-n", "default")
+				_, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred(), "Secret valkey-exporter-tls not found yet")
+			}, "1m", "5s").Should(Succeed())
+
+			By("creating a ValkeyCluster with the exporter's metrics endpoint served over HTTPS")
+			valkeyName := "valkey-cluster-exporter-tls"
+			valkeyYaml := fmt.Sprintf(`
+apiVersion: valkey.io/v1alpha1
+kind: ValkeyCluster
+metadata:
+  name: %s
+spec:
+  shards: 1
+  replicas: 0
+  exporter:
+    tls:
+      enabled: true
+      serverCertSecret: valkey-exporter-tls
+`, valkeyName)
+
+			manifestFile := filepath.Join(os.TempDir(), fmt.Sprintf("%s.yaml", valkeyName))
+			err = os.WriteFile(manifestFile, []byte(valkeyYaml), 0644)
+			Expect(err).NotTo(HaveOccurred(), "Failed to write manifest file")
+			defer os.Remove(manifestFile)
+
+			cmd := exec.Command("kubectl", "create", "-f", manifestFile)
+			_, err = utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to create ValkeyCluster CR")
+			defer func() {
+				cmd := exec.Command("kubectl", "delete", "valkeycluster", valkeyName, "--ignore-not-found=true")
+				utils.Run(cmd)
+			}()
+
+			By("validating that the pods are running")
+			Eventually(func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "pods",
+					"-l", fmt.Sprintf("app.kubernetes.io/instance=%s", valkeyName),
+					"-o", "jsonpath={.items[*].status.phase}",
+				)
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(ContainSubstring("Running"))
+			}).Should(Succeed())
+
+			By("Getting pod IP for metrics verification")
+			var podIP string
+			Eventually(func(g Gomega) {
+				args := []string{
+					"get", "pods", "-l", "app.kubernetes.io/instance=" + valkeyName,
+					"-o", "jsonpath={.items[0].status.podIP}",
+				}
+				cmd := exec.Command("kubectl", args...)
+				out, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred(), "Failed to get pod IP")
+				g.Expect(out).NotTo(BeEmpty(), "Pod IP should not be empty")
+				podIP = out
+			}).Should(Succeed())
+
+			By("verifying the exporter's /metrics endpoint is served over HTTPS using the cert-manager CA")
+			Eventually(func(g Gomega) {
+				// The certificate only names localhost, so resolve that name to the pod IP.
+				url := "https://localhost:9121/metrics"
+				resolve := fmt.Sprintf("localhost:9121:%s", podIP)
+				cmd := exec.Command("kubectl", "run", "curl-exporter-tls-"+valkeyName, "--rm", "-i", "--restart=Never",
+					"--image=curlimages/curl:latest", "--overrides", fmt.Sprintf(`{
+						"spec": {
+							"volumes": [{"name": "ca", "secret": {"secretName": "valkey-exporter-tls"}}],
+							"containers": [{
+								"name": "curl",
+								"image": "curlimages/curl:latest",
+								"command": ["curl", "-s", "--cacert", "/ca/ca.crt", "--resolve", "%s", "%s"],
+								"volumeMounts": [{"name": "ca", "mountPath": "/ca"}]
+							}]
+						}
+					}`, resolve, url))
+				out, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred(), "Failed to curl https metrics endpoint")
+				g.Expect(out).To(ContainSubstring("redis_up"), "Should contain redis_up metric")
+			}, "1m", "5s").Should(Succeed())
+
+			By("Cleaning up test resources")
+			cmd = exec.Command("kubectl", "delete", "valkeycluster", valkeyName, "--ignore-not-found=true")
+			utils.Run(cmd)
+		})
+
 		It("should fail when TLS is enabled with missing secret", func() {
 			By("creating a ValkeyCluster with missing secret")
 			valkeyName := "valkey-cluster-tls-missing-secret"